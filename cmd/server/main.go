@@ -0,0 +1,99 @@
+// Command server runs the HTTP API.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/controllers"
+	"github.com/4xguy/project-index/pkg/jobs"
+	"github.com/4xguy/project-index/pkg/logging"
+	"github.com/4xguy/project-index/pkg/metrics"
+	"github.com/4xguy/project-index/pkg/services"
+	"github.com/4xguy/project-index/pkg/storage"
+	"github.com/4xguy/project-index/pkg/storage/objects"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to YAML config file")
+	flag.Parse()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(fmt.Sprintf("failed to create logger: %v", err))
+	}
+	defer logger.Sync()
+
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatal("failed to load config", zap.Error(err))
+	}
+
+	db, err := storage.NewPostgresDB(cfg.Database)
+	if err != nil {
+		logger.Fatal("failed to connect to database", zap.Error(err))
+	}
+
+	jobsClient := jobs.NewClient(asynq.RedisClientOpt{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer jobsClient.Close()
+
+	objectStore, err := objects.NewMinioStore(cfg.Storage.Endpoint, cfg.Storage.AccessKey, cfg.Storage.SecretKey, cfg.Storage.UseSSL)
+	if err != nil {
+		logger.Fatal("failed to create object store", zap.Error(err))
+	}
+
+	userService := services.NewUserService(db)
+
+	var registry *prometheus.Registry
+	var appMetrics *metrics.Metrics
+	if cfg.Metrics.Enabled {
+		registry = prometheus.NewRegistry()
+		appMetrics = metrics.New(cfg.Metrics.Namespace, cfg.Metrics.Subsystem, registry)
+		userService = services.NewInstrumentedUserService(userService,
+			prometheus.WrapRegistererWithPrefix(metricsPrefix(cfg.Metrics), registry))
+	}
+
+	userController := controllers.NewUserController(userService, cfg, jobsClient)
+	avatarController := controllers.NewAvatarController(userService, cfg, objectStore)
+
+	router := mux.NewRouter()
+	usersRouter := userController.RegisterRoutes(router)
+	avatarController.RegisterRoutes(usersRouter)
+
+	router.Use(logging.WithLogging(logger))
+	if cfg.Metrics.Enabled {
+		router.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		router.Use(appMetrics.WithMetrics)
+	}
+
+	logger.Info("server starting", zap.Int("port", cfg.Port))
+	logger.Fatal("server exited", zap.Error(http.ListenAndServe(fmt.Sprintf(":%d", cfg.Port), router)))
+}
+
+// metricsPrefix builds the "namespace_subsystem_" label prefix applied to
+// collectors that, unlike metrics.New's, aren't already namespace-aware.
+func metricsPrefix(cfg config.MetricsConfig) string {
+	switch {
+	case cfg.Namespace != "" && cfg.Subsystem != "":
+		return cfg.Namespace + "_" + cfg.Subsystem + "_"
+	case cfg.Namespace != "":
+		return cfg.Namespace + "_"
+	case cfg.Subsystem != "":
+		return cfg.Subsystem + "_"
+	default:
+		return ""
+	}
+}
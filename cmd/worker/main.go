@@ -0,0 +1,70 @@
+// Command worker runs the asynq task server that processes queued jobs.
+package main
+
+import (
+	"flag"
+	"net/http"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/jobs"
+	"github.com/4xguy/project-index/pkg/metrics"
+	"github.com/4xguy/project-index/pkg/services"
+	"github.com/4xguy/project-index/pkg/storage"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to YAML config file")
+	concurrency := flag.Int("concurrency", 10, "number of concurrent task workers")
+	metricsAddr := flag.String("metrics-addr", ":9091", "address to serve Prometheus metrics on")
+	flag.Parse()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	cfg, err := config.LoadConfig(*configPath)
+	if err != nil {
+		logger.Fatal("failed to load config", zap.Error(err))
+	}
+
+	db, err := storage.NewPostgresDB(cfg.Database)
+	if err != nil {
+		logger.Fatal("failed to connect to database", zap.Error(err))
+	}
+
+	userService := services.NewUserService(db)
+
+	handler := jobs.NewUserProcessHandler(userService)
+	if cfg.Metrics.Enabled {
+		registry := prometheus.NewRegistry()
+		appMetrics := metrics.New(cfg.Metrics.Namespace, cfg.Metrics.Subsystem, registry)
+		handler = appMetrics.WithJobMetrics(jobs.TypeUserProcess, handler)
+
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+			if err := http.ListenAndServe(*metricsAddr, mux); err != nil {
+				logger.Error("metrics server stopped", zap.Error(err))
+			}
+		}()
+	}
+
+	server := jobs.NewServer(asynq.RedisClientOpt{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	}, *concurrency)
+	server.RegisterUserProcessHandler(handler)
+
+	logger.Info("worker starting", zap.Int("concurrency", *concurrency))
+	if err := server.Run(); err != nil {
+		logger.Fatal("worker server failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGenerateAndParseToken(t *testing.T) {
+	token, err := GenerateToken("test-secret", time.Hour, 42, "admin")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	claims, err := ParseToken("test-secret", token)
+	if err != nil {
+		t.Fatalf("ParseToken() error = %v", err)
+	}
+	if claims.UserID != 42 {
+		t.Errorf("UserID = %d, want 42", claims.UserID)
+	}
+	if claims.Role != "admin" {
+		t.Errorf("Role = %q, want admin", claims.Role)
+	}
+}
+
+func TestParseTokenWrongSecret(t *testing.T) {
+	token, err := GenerateToken("test-secret", time.Hour, 1, "user")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	if _, err := ParseToken("other-secret", token); err == nil {
+		t.Error("ParseToken() error = nil, want error for wrong secret")
+	}
+}
+
+func TestParseTokenExpired(t *testing.T) {
+	token, err := GenerateToken("test-secret", -time.Hour, 1, "user")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	if _, err := ParseToken("test-secret", token); err == nil {
+		t.Error("ParseToken() error = nil, want error for expired token")
+	}
+}
+
+func withClaims(r *http.Request, claims *Claims) *http.Request {
+	ctx := context.WithValue(r.Context(), claimsContextKey, claims)
+	return r.WithContext(ctx)
+}
+
+func TestRequireRoleAllowsMatchingRole(t *testing.T) {
+	called := false
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := withClaims(httptest.NewRequest(http.MethodDelete, "/users/1", nil), &Claims{UserID: 1, Role: "admin"})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if !called {
+		t.Error("handler was not called for matching role")
+	}
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestRequireRoleRejectsOtherRole(t *testing.T) {
+	called := false
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	r := withClaims(httptest.NewRequest(http.MethodDelete, "/users/1", nil), &Claims{UserID: 1, Role: "user"})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if called {
+		t.Error("handler was called for non-matching role")
+	}
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestRequireRoleRejectsMissingClaims(t *testing.T) {
+	handler := RequireRole("admin")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler was called without claims in context")
+	}))
+
+	r := httptest.NewRequest(http.MethodDelete, "/users/1", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+}
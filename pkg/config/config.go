@@ -0,0 +1,120 @@
+// Package config loads application configuration from a YAML file, with
+// environment variables taking precedence over file values.
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config holds application configuration
+type Config struct {
+	Port      int           `yaml:"port"`
+	Database  string        `yaml:"database"`
+	JWTSecret string        `yaml:"jwt_secret"`
+	TokenTTL  time.Duration `yaml:"token_ttl"`
+	Redis     RedisConfig   `yaml:"redis"`
+	Storage   StorageConfig `yaml:"storage"`
+	Metrics   MetricsConfig `yaml:"metrics"`
+}
+
+// RedisConfig holds the connection settings for the asynq task queue.
+type RedisConfig struct {
+	Addr     string `yaml:"addr"`
+	DB       int    `yaml:"db"`
+	Password string `yaml:"password"`
+}
+
+// StorageConfig holds the connection settings for the object store used to
+// hold user-uploaded files such as avatars.
+type StorageConfig struct {
+	Endpoint  string `yaml:"endpoint"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	Bucket    string `yaml:"bucket"`
+	UseSSL    bool   `yaml:"use_ssl"`
+}
+
+// MetricsConfig controls Prometheus metrics collection. Namespace and
+// Subsystem are used as label prefixes on every collector so multiple
+// deployments can share a Prometheus instance without colliding.
+type MetricsConfig struct {
+	Namespace string `yaml:"namespace"`
+	Subsystem string `yaml:"subsystem"`
+	Enabled   bool   `yaml:"enabled"`
+}
+
+// Default returns the default configuration used when no file is supplied.
+func Default() Config {
+	return Config{
+		Port:      8080,
+		Database:  "postgres://localhost/app",
+		JWTSecret: "change-me",
+		TokenTTL:  24 * time.Hour,
+		Redis: RedisConfig{
+			Addr: "localhost:6379",
+		},
+		Storage: StorageConfig{
+			Endpoint: "localhost:9000",
+			Bucket:   "avatars",
+		},
+		Metrics: MetricsConfig{
+			Namespace: "app",
+			Enabled:   true,
+		},
+	}
+}
+
+// LoadConfig reads a YAML config file from path and applies any matching
+// environment variable overrides (APP_PORT, APP_DATABASE, APP_JWT_SECRET,
+// APP_TOKEN_TTL, APP_REDIS_ADDR). An empty path returns the defaults with overrides applied.
+func LoadConfig(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	if err := applyEnvOverrides(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+func applyEnvOverrides(cfg *Config) error {
+	if v := os.Getenv("APP_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid APP_PORT: %w", err)
+		}
+		cfg.Port = port
+	}
+	if v := os.Getenv("APP_DATABASE"); v != "" {
+		cfg.Database = v
+	}
+	if v := os.Getenv("APP_JWT_SECRET"); v != "" {
+		cfg.JWTSecret = v
+	}
+	if v := os.Getenv("APP_TOKEN_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid APP_TOKEN_TTL: %w", err)
+		}
+		cfg.TokenTTL = ttl
+	}
+	if v := os.Getenv("APP_REDIS_ADDR"); v != "" {
+		cfg.Redis.Addr = v
+	}
+	return nil
+}
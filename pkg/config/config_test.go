@@ -0,0 +1,35 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.Port != 8080 {
+		t.Errorf("Port = %d, want 8080", cfg.Port)
+	}
+}
+
+func TestLoadConfigEnvOverrides(t *testing.T) {
+	os.Setenv("APP_PORT", "9090")
+	os.Setenv("APP_TOKEN_TTL", "1h")
+	defer os.Unsetenv("APP_PORT")
+	defer os.Unsetenv("APP_TOKEN_TTL")
+
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.Port != 9090 {
+		t.Errorf("Port = %d, want 9090", cfg.Port)
+	}
+	if cfg.TokenTTL != time.Hour {
+		t.Errorf("TokenTTL = %v, want 1h", cfg.TokenTTL)
+	}
+}
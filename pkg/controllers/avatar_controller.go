@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/services"
+	"github.com/4xguy/project-index/pkg/storage/objects"
+)
+
+const (
+	maxAvatarSize      = 5 << 20 // 5MB
+	presignedURLExpiry = 15 * time.Minute
+)
+
+var avatarContentTypes = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+	"image/webp": ".webp",
+}
+
+// AvatarController handles avatar uploads and downloads.
+type AvatarController struct {
+	service services.UserService
+	cfg     *config.Config
+	store   objects.ObjectStore
+}
+
+// NewAvatarController creates a new AvatarController.
+func NewAvatarController(service services.UserService, cfg *config.Config, store objects.ObjectStore) *AvatarController {
+	return &AvatarController{service: service, cfg: cfg, store: store}
+}
+
+// RegisterRoutes mounts the controller's routes on r. r must already be
+// wrapped with auth.RequireAuth.
+func (c *AvatarController) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/{id:[0-9]+}/avatar", c.uploadAvatar).Methods(http.MethodPost)
+	r.HandleFunc("/{id:[0-9]+}/avatar", c.getAvatar).Methods(http.MethodGet)
+}
+
+func (c *AvatarController) uploadAvatar(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromRequest(r)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid user id"))
+		return
+	}
+	if _, err := requireSelfOrAdmin(r, id); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAvatarSize)
+	file, header, err := r.FormFile("avatar")
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "missing avatar file"))
+		return
+	}
+	defer file.Close()
+
+	contentType := header.Header.Get("Content-Type")
+	ext, ok := avatarContentTypes[contentType]
+	if !ok {
+		errs.WriteError(w, errs.New(errs.ValidationFailed, "unsupported content type: "+contentType))
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "failed to read upload"))
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:]) + ext
+
+	url, err := c.store.PutObject(r.Context(), c.cfg.Storage.Bucket, key, bytes.NewReader(data), int64(len(data)), contentType)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.Internal, "failed to store avatar"))
+		return
+	}
+
+	user, err := c.service.SetAvatarURL(id, url)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	writeJSON(w, user)
+}
+
+func (c *AvatarController) getAvatar(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromRequest(r)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid user id"))
+		return
+	}
+	if _, err := requireSelfOrAdmin(r, id); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	user, err := c.service.GetUser(id)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+	if user.AvatarURL == "" {
+		errs.WriteError(w, errs.New(errs.NotFound, "user has no avatar"))
+		return
+	}
+
+	presigned, err := c.store.PresignedGetURL(r.Context(), c.cfg.Storage.Bucket, path.Base(user.AvatarURL), presignedURLExpiry)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.Internal, "failed to presign avatar url"))
+		return
+	}
+
+	http.Redirect(w, r, presigned, http.StatusFound)
+}
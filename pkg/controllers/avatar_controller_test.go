@@ -0,0 +1,350 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/4xguy/project-index/pkg/auth"
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+	"github.com/4xguy/project-index/pkg/services"
+)
+
+const avatarTestSecret = "avatar-test-secret"
+
+// fakeUserService is an in-memory services.UserService used to test the
+// avatar controller without a real database.
+type fakeUserService struct {
+	users map[int]*models.User
+}
+
+func newFakeUserService() *fakeUserService {
+	return &fakeUserService{users: make(map[int]*models.User)}
+}
+
+func (f *fakeUserService) ListUsers() ([]*models.User, error) {
+	var users []*models.User
+	for _, u := range f.users {
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (f *fakeUserService) GetUser(id int) (*models.User, error) {
+	if u, ok := f.users[id]; ok {
+		return u, nil
+	}
+	return nil, errs.New(errs.NotFound, "user not found")
+}
+
+func (f *fakeUserService) CreateUser(user *models.User) error {
+	user.ID = len(f.users) + 1
+	f.users[user.ID] = user
+	return nil
+}
+
+func (f *fakeUserService) UpdateUser(user *models.User) error {
+	if _, ok := f.users[user.ID]; !ok {
+		return errs.New(errs.NotFound, "user not found")
+	}
+	f.users[user.ID] = user
+	return nil
+}
+
+func (f *fakeUserService) DeleteUser(id int) error {
+	delete(f.users, id)
+	return nil
+}
+
+func (f *fakeUserService) Authenticate(email, password string) (*models.User, error) {
+	for _, u := range f.users {
+		if u.Email == email && u.CheckPassword(password) {
+			return u, nil
+		}
+	}
+	return nil, errs.New(errs.Unauthenticated, "invalid credentials")
+}
+
+func (f *fakeUserService) SetAvatarURL(id int, avatarURL string) (*models.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, errs.New(errs.NotFound, "user not found")
+	}
+	u.AvatarURL = avatarURL
+	return u, nil
+}
+
+// fakeObjectStore is an in-memory objects.ObjectStore used to test the
+// avatar controller without a real MinIO endpoint.
+type fakeObjectStore struct {
+	objects map[string][]byte
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{objects: make(map[string][]byte)}
+}
+
+func (f *fakeObjectStore) PutObject(ctx context.Context, bucket, key string, r io.Reader, size int64, contentType string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	f.objects[key] = data
+	return "https://objects.example.com/" + bucket + "/" + key, nil
+}
+
+func (f *fakeObjectStore) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	data, ok := f.objects[key]
+	if !ok {
+		return nil, errs.New(errs.NotFound, "object not found")
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeObjectStore) DeleteObject(ctx context.Context, bucket, key string) error {
+	delete(f.objects, key)
+	return nil
+}
+
+func (f *fakeObjectStore) PresignedGetURL(ctx context.Context, bucket, key string, expiry time.Duration) (string, error) {
+	if _, ok := f.objects[key]; !ok {
+		return "", errs.New(errs.NotFound, "object not found")
+	}
+	return "https://objects.example.com/presigned/" + bucket + "/" + key, nil
+}
+
+func newTestAvatarController(t *testing.T) (*AvatarController, services.UserService, *fakeObjectStore) {
+	t.Helper()
+	svc := newFakeUserService()
+	store := newFakeObjectStore()
+	cfg := config.Default()
+	cfg.JWTSecret = avatarTestSecret
+	return NewAvatarController(svc, &cfg, store), svc, store
+}
+
+// asCaller attaches a bearer token for (userID, role) to r. serveAuthed then
+// runs it through auth.RequireAuth, the same way the route is reached in
+// production.
+func asCaller(t *testing.T, r *http.Request, userID int, role string) *http.Request {
+	t.Helper()
+	token, err := auth.GenerateToken(avatarTestSecret, time.Hour, userID, role)
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+func serveAuthed(handler http.HandlerFunc, r *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	auth.RequireAuth(avatarTestSecret)(handler).ServeHTTP(w, r)
+	return w
+}
+
+func newAvatarUploadRequest(t *testing.T, id int, contentType string, data []byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreatePart(map[string][]string{
+		"Content-Disposition": {`form-data; name="avatar"; filename="avatar"`},
+		"Content-Type":        {contentType},
+	})
+	if err != nil {
+		t.Fatalf("CreatePart() error = %v", err)
+	}
+	if _, err := part.Write(data); err != nil {
+		t.Fatalf("part.Write() error = %v", err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatalf("mw.Close() error = %v", err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/users/1/avatar", &body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	return mux.SetURLVars(r, map[string]string{"id": strconv.Itoa(id)})
+}
+
+func TestUploadAvatarStoresObjectAndSetsAvatarURL(t *testing.T) {
+	c, svc, store := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	data := []byte("fake png bytes")
+	r := asCaller(t, newAvatarUploadRequest(t, user.ID, "image/png", data), user.ID, "")
+	w := serveAuthed(c.uploadAvatar, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	got, err := svc.GetUser(user.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if got.AvatarURL == "" {
+		t.Fatal("AvatarURL = \"\", want it to be set after upload")
+	}
+
+	wantKey := "86610c40efe63f0a46c58c4b605c164b4ffa3a3ad3f1dcf13e6ba4c59cb3ce16.png"
+	stored, ok := store.objects[wantKey]
+	if !ok {
+		t.Fatalf("no object stored under content-addressed key %q, got keys %v", wantKey, store.objects)
+	}
+	if string(stored) != string(data) {
+		t.Error("stored object content does not match the uploaded file")
+	}
+}
+
+func TestUploadAvatarRejectsUnsupportedContentType(t *testing.T) {
+	c, svc, store := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	r := asCaller(t, newAvatarUploadRequest(t, user.ID, "application/pdf", []byte("not an image")), user.ID, "")
+	w := serveAuthed(c.uploadAvatar, r)
+
+	if w.Code == http.StatusOK {
+		t.Fatal("status = 200, want an error status for unsupported content type")
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("store has %d objects, want 0 for a rejected upload", len(store.objects))
+	}
+}
+
+func TestUploadAvatarRejectsOversizedFile(t *testing.T) {
+	c, svc, store := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	oversized := bytes.Repeat([]byte("a"), maxAvatarSize+1)
+	r := asCaller(t, newAvatarUploadRequest(t, user.ID, "image/png", oversized), user.ID, "")
+	w := serveAuthed(c.uploadAvatar, r)
+
+	if w.Code == http.StatusOK {
+		t.Fatal("status = 200, want an error status for an oversized upload")
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("store has %d objects, want 0 for a rejected upload", len(store.objects))
+	}
+}
+
+func TestUploadAvatarRejectsNonOwnerNonAdmin(t *testing.T) {
+	c, svc, store := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	attacker := &models.User{Name: "Mallory", Email: "mallory@example.com"}
+	if err := svc.CreateUser(attacker); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	r := asCaller(t, newAvatarUploadRequest(t, user.ID, "image/png", []byte("fake png bytes")), attacker.ID, "")
+	w := serveAuthed(c.uploadAvatar, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("store has %d objects, want 0 for a rejected upload", len(store.objects))
+	}
+}
+
+func TestGetAvatarRedirectsToPresignedURL(t *testing.T) {
+	c, svc, _ := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	data := []byte("fake png bytes")
+	upload := asCaller(t, newAvatarUploadRequest(t, user.ID, "image/png", data), user.ID, "")
+	uploadW := serveAuthed(c.uploadAvatar, upload)
+	if uploadW.Code != http.StatusOK {
+		t.Fatalf("upload status = %d, want %d", uploadW.Code, http.StatusOK)
+	}
+
+	r := asCaller(t, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/users/1/avatar", nil), map[string]string{"id": strconv.Itoa(user.ID)}), user.ID, "")
+	w := serveAuthed(c.getAvatar, r)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d", w.Code, http.StatusFound)
+	}
+	if loc := w.Header().Get("Location"); loc == "" {
+		t.Error("Location header is empty, want a presigned URL")
+	}
+}
+
+func TestGetAvatarNotFoundWithoutAvatar(t *testing.T) {
+	c, svc, _ := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	r := asCaller(t, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/users/1/avatar", nil), map[string]string{"id": strconv.Itoa(user.ID)}), user.ID, "")
+	w := serveAuthed(c.getAvatar, r)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d for a user without an avatar", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetAvatarRejectsNonOwnerNonAdmin(t *testing.T) {
+	c, svc, _ := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	attacker := &models.User{Name: "Mallory", Email: "mallory@example.com"}
+	if err := svc.CreateUser(attacker); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	r := asCaller(t, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/users/1/avatar", nil), map[string]string{"id": strconv.Itoa(user.ID)}), attacker.ID, "")
+	w := serveAuthed(c.getAvatar, r)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+}
+
+func TestGetAvatarAllowsAdminForAnotherUser(t *testing.T) {
+	c, svc, _ := newTestAvatarController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	admin := &models.User{Name: "Admin", Email: "admin@example.com", Role: "admin"}
+	if err := svc.CreateUser(admin); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	upload := asCaller(t, newAvatarUploadRequest(t, user.ID, "image/png", []byte("fake png bytes")), user.ID, "")
+	if w := serveAuthed(c.uploadAvatar, upload); w.Code != http.StatusOK {
+		t.Fatalf("upload status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	r := asCaller(t, mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/users/1/avatar", nil), map[string]string{"id": strconv.Itoa(user.ID)}), admin.ID, "admin")
+	w := serveAuthed(c.getAvatar, r)
+
+	if w.Code != http.StatusFound {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusFound, w.Body.String())
+	}
+}
@@ -0,0 +1,257 @@
+// Package controllers wires HTTP routes to the services layer.
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/4xguy/project-index/pkg/auth"
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/jobs"
+	"github.com/4xguy/project-index/pkg/logging"
+	"github.com/4xguy/project-index/pkg/models"
+	"github.com/4xguy/project-index/pkg/services"
+)
+
+// UserController exposes the user-related HTTP handlers.
+type UserController struct {
+	service    services.UserService
+	cfg        *config.Config
+	jobsClient *jobs.Client
+}
+
+// NewUserController creates a new UserController.
+func NewUserController(service services.UserService, cfg *config.Config, jobsClient *jobs.Client) *UserController {
+	return &UserController{service: service, cfg: cfg, jobsClient: jobsClient}
+}
+
+// RegisterRoutes mounts the controller's routes on r and returns the
+// authenticated "/users" subrouter so related controllers (e.g.
+// AvatarController) can mount their own routes under it.
+func (c *UserController) RegisterRoutes(r *mux.Router) *mux.Router {
+	r.HandleFunc("/login", c.login).Methods(http.MethodPost)
+
+	users := r.PathPrefix("/users").Subrouter()
+	users.Use(auth.RequireAuth(c.cfg.JWTSecret))
+	users.HandleFunc("", c.listUsers).Methods(http.MethodGet)
+	users.HandleFunc("", c.createUser).Methods(http.MethodPost)
+	users.HandleFunc("/{id:[0-9]+}", c.getUser).Methods(http.MethodGet)
+	users.HandleFunc("/{id:[0-9]+}", c.updateUser).Methods(http.MethodPut)
+	users.Handle("/{id:[0-9]+}", auth.RequireRole("admin")(http.HandlerFunc(c.deleteUser))).Methods(http.MethodDelete)
+	return users
+}
+
+// requestClaims resolves the authenticated caller's claims injected by
+// auth.RequireAuth and enriches the request's logger with its user ID.
+func requestClaims(r *http.Request) (*auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(r.Context())
+	if !ok {
+		return nil, errs.New(errs.Unauthenticated, "authentication required")
+	}
+	logging.AddFields(r.Context(), zap.Int("user_id", claims.UserID))
+	return claims, nil
+}
+
+// requireSelfOrAdmin resolves the caller's claims and rejects the request
+// unless the caller is id or holds the admin role.
+func requireSelfOrAdmin(r *http.Request, id int) (*auth.Claims, error) {
+	claims, err := requestClaims(r)
+	if err != nil {
+		return nil, err
+	}
+	if claims.UserID != id && claims.Role != "admin" {
+		return nil, errs.New(errs.NoPermission, "cannot act on another user's behalf")
+	}
+	return claims, nil
+}
+
+// actingUser resolves the authenticated caller injected by auth.RequireAuth.
+func (c *UserController) actingUser(r *http.Request) (*models.User, error) {
+	claims, err := requestClaims(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.service.GetUser(claims.UserID)
+}
+
+func (c *UserController) login(w http.ResponseWriter, r *http.Request) {
+	var creds struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := readJSON(r, &creds); err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid request body"))
+		return
+	}
+
+	user, err := c.service.Authenticate(creds.Email, creds.Password)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	token, err := auth.GenerateToken(c.cfg.JWTSecret, c.cfg.TokenTTL, user.GetID(), user.Role)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.Internal, "failed to issue token"))
+		return
+	}
+
+	writeJSON(w, map[string]string{"token": token})
+}
+
+func (c *UserController) listUsers(w http.ResponseWriter, r *http.Request) {
+	if _, err := c.actingUser(r); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	users, err := c.service.ListUsers()
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	writeJSON(w, users)
+}
+
+type createUserRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+func (c *UserController) createUser(w http.ResponseWriter, r *http.Request) {
+	claims, err := requestClaims(r)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	var req createUserRequest
+	if err := readJSON(r, &req); err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid request body"))
+		return
+	}
+	if req.Role != "" && claims.Role != "admin" {
+		errs.WriteError(w, errs.New(errs.NoPermission, "admin role required to set role"))
+		return
+	}
+
+	user := models.User{Name: req.Name, Email: req.Email, Role: req.Role}
+	if err := user.SetPassword(req.Password); err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid password"))
+		return
+	}
+
+	if err := c.service.CreateUser(&user); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	if _, err := c.jobsClient.EnqueueUserProcess(r.Context(), user.GetID()); err != nil {
+		logging.FromContext(r.Context()).Warn("failed to enqueue user process task",
+			zap.Int("user_id", user.GetID()), zap.Error(err))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	writeJSON(w, user)
+}
+
+func (c *UserController) getUser(w http.ResponseWriter, r *http.Request) {
+	if _, err := c.actingUser(r); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	id, err := idFromRequest(r)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid user id"))
+		return
+	}
+
+	user, err := c.service.GetUser(id)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	writeJSON(w, user)
+}
+
+func (c *UserController) updateUser(w http.ResponseWriter, r *http.Request) {
+	id, err := idFromRequest(r)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid user id"))
+		return
+	}
+
+	claims, err := requireSelfOrAdmin(r, id)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	user, err := c.service.GetUser(id)
+	if err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	var req struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid request body"))
+		return
+	}
+	if req.Role != "" && req.Role != user.Role && claims.Role != "admin" {
+		errs.WriteError(w, errs.New(errs.NoPermission, "admin role required to set role"))
+		return
+	}
+
+	user.Name = req.Name
+	user.Email = req.Email
+	if req.Role != "" {
+		user.Role = req.Role
+	}
+
+	if err := c.service.UpdateUser(user); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	writeJSON(w, user)
+}
+
+// deleteUser is only reachable via the "admin" role middleware installed in
+// RegisterRoutes.
+func (c *UserController) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if _, err := requestClaims(r); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	id, err := idFromRequest(r)
+	if err != nil {
+		errs.WriteError(w, errs.Wrap(err, errs.ValidationFailed, "invalid user id"))
+		return
+	}
+
+	if err := c.service.DeleteUser(id); err != nil {
+		errs.WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func idFromRequest(r *http.Request) (int, error) {
+	return strconv.Atoi(mux.Vars(r)["id"])
+}
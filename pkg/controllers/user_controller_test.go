@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/hibiken/asynq"
+
+	"github.com/4xguy/project-index/pkg/auth"
+	"github.com/4xguy/project-index/pkg/config"
+	"github.com/4xguy/project-index/pkg/jobs"
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+func newTestUserController(t *testing.T) (*UserController, *fakeUserService) {
+	t.Helper()
+	svc := newFakeUserService()
+	cfg := config.Default()
+	cfg.JWTSecret = "test-secret"
+	cfg.TokenTTL = time.Hour
+	jobsClient := jobs.NewClient(asynq.RedisClientOpt{Addr: "127.0.0.1:1"})
+	return NewUserController(svc, &cfg, jobsClient), svc
+}
+
+// callAuthed runs handler through auth.RequireAuth with a real token for
+// (userID, role), the same way the route would be reached in production.
+func callAuthed(t *testing.T, secret string, userID int, role string, handler http.HandlerFunc, method, target string, body *strings.Reader, vars map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	token, err := auth.GenerateToken(secret, time.Hour, userID, role)
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	var r *http.Request
+	if body != nil {
+		r = httptest.NewRequest(method, target, body)
+	} else {
+		r = httptest.NewRequest(method, target, nil)
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Authorization", "Bearer "+token)
+	if vars != nil {
+		r = mux.SetURLVars(r, vars)
+	}
+
+	w := httptest.NewRecorder()
+	auth.RequireAuth(secret)(handler).ServeHTTP(w, r)
+	return w
+}
+
+func TestUpdateUserPreservesPasswordHash(t *testing.T) {
+	c, svc := newTestUserController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := user.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Ada Lovelace","email":"ada@example.com"}`)
+	w := callAuthed(t, "test-secret", user.ID, "", c.updateUser, http.MethodPut, "/users/"+strconv.Itoa(user.ID), body, map[string]string{"id": strconv.Itoa(user.ID)})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	got, err := svc.GetUser(user.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if !got.CheckPassword("s3cret") {
+		t.Error("CheckPassword() = false after an update that didn't touch the password, want true")
+	}
+	if got.Name != "Ada Lovelace" {
+		t.Errorf("Name = %q, want %q", got.Name, "Ada Lovelace")
+	}
+}
+
+func TestUpdateUserRejectsRoleChangeByNonAdmin(t *testing.T) {
+	c, svc := newTestUserController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Ada","email":"ada@example.com","role":"admin"}`)
+	w := callAuthed(t, "test-secret", user.ID, "", c.updateUser, http.MethodPut, "/users/"+strconv.Itoa(user.ID), body, map[string]string{"id": strconv.Itoa(user.ID)})
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+
+	got, err := svc.GetUser(user.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if got.Role == "admin" {
+		t.Error("Role = admin, want unchanged after a rejected self-promotion attempt")
+	}
+}
+
+func TestUpdateUserWithoutRoleFieldPreservesExistingRole(t *testing.T) {
+	c, svc := newTestUserController(t)
+	admin := &models.User{Name: "Admin", Email: "admin@example.com", Role: "admin"}
+	if err := svc.CreateUser(admin); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Administrator","email":"admin@example.com"}`)
+	w := callAuthed(t, "test-secret", admin.ID, "admin", c.updateUser, http.MethodPut, "/users/"+strconv.Itoa(admin.ID), body, map[string]string{"id": strconv.Itoa(admin.ID)})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	got, err := svc.GetUser(admin.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if got.Role != "admin" {
+		t.Errorf("Role = %q, want it unchanged (%q) when the request omits role", got.Role, "admin")
+	}
+}
+
+func TestUpdateUserRejectsUpdatingAnotherUser(t *testing.T) {
+	c, svc := newTestUserController(t)
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	other := &models.User{Name: "Bob", Email: "bob@example.com"}
+	if err := svc.CreateUser(other); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Hacked","email":"bob@example.com"}`)
+	w := callAuthed(t, "test-secret", user.ID, "", c.updateUser, http.MethodPut, "/users/"+strconv.Itoa(other.ID), body, map[string]string{"id": strconv.Itoa(other.ID)})
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+}
+
+func TestCreateUserRejectsRoleByNonAdmin(t *testing.T) {
+	c, svc := newTestUserController(t)
+	caller := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(caller); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Mallory","email":"mallory@example.com","password":"mpass","role":"admin"}`)
+	w := callAuthed(t, "test-secret", caller.ID, "", c.createUser, http.MethodPost, "/users", body, nil)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusForbidden, w.Body.String())
+	}
+}
+
+func TestCreateUserThreadsPasswordThroughToLogin(t *testing.T) {
+	c, svc := newTestUserController(t)
+	caller := &models.User{Name: "Admin", Email: "admin@example.com", Role: "admin"}
+	if err := svc.CreateUser(caller); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	body := strings.NewReader(`{"name":"Eve","email":"eve@example.com","password":"evepass"}`)
+	w := callAuthed(t, "test-secret", caller.ID, "admin", c.createUser, http.MethodPost, "/users", body, nil)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d, body = %s", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	eve, err := svc.GetUser(2)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if !eve.CheckPassword("evepass") {
+		t.Error("CheckPassword() = false for the password sent on create, want true")
+	}
+}
@@ -0,0 +1,76 @@
+// Package errs provides a typed error type carrying an application-level
+// error code, so handlers can map failures to the right HTTP response
+// without inspecting error strings.
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code classifies the kind of failure an Error represents.
+type Code string
+
+const (
+	ValidationFailed Code = "VALIDATION_FAILED"
+	NotFound         Code = "NOT_FOUND"
+	AlreadyExists    Code = "ALREADY_EXISTS"
+	NoPermission     Code = "NO_PERMISSION"
+	Unauthenticated  Code = "UNAUTHENTICATED"
+	Conflict         Code = "CONFLICT"
+	Internal         Code = "INTERNAL"
+	DeadlineExceeded Code = "DEADLINE_EXCEEDED"
+)
+
+// Detail carries a field-level validation failure.
+type Detail struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error is a typed application error.
+type Error struct {
+	Code    Code
+	Message string
+	Cause   error
+	Details []Detail
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Cause)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the wrapped cause, if any.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// WithDetails attaches field-level details and returns the receiver.
+func (e *Error) WithDetails(details ...Detail) *Error {
+	e.Details = append(e.Details, details...)
+	return e
+}
+
+// New creates an Error with the given code and message.
+func New(code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg}
+}
+
+// Wrap creates an Error with the given code and message, wrapping cause.
+func Wrap(cause error, code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg, Cause: cause}
+}
+
+// CodeOf returns the Code carried by err, or Internal if err does not wrap
+// an *Error.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return Internal
+}
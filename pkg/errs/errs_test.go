@@ -0,0 +1,34 @@
+package errs
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCodeOf(t *testing.T) {
+	err := New(NotFound, "user not found")
+	if code := CodeOf(err); code != NotFound {
+		t.Errorf("CodeOf() = %v, want NotFound", code)
+	}
+
+	wrapped := Wrap(errors.New("boom"), Conflict, "duplicate email")
+	if code := CodeOf(wrapped); code != Conflict {
+		t.Errorf("CodeOf() = %v, want Conflict", code)
+	}
+
+	if code := CodeOf(errors.New("plain")); code != Internal {
+		t.Errorf("CodeOf() = %v, want Internal", code)
+	}
+}
+
+func TestWithDetails(t *testing.T) {
+	err := New(ValidationFailed, "validation failed").WithDetails(
+		Detail{Field: "name", Message: "name is required"},
+	)
+	if len(err.Details) != 1 {
+		t.Fatalf("Details = %v, want 1 entry", err.Details)
+	}
+	if err.Details[0].Field != "name" {
+		t.Errorf("Details[0].Field = %q, want name", err.Details[0].Field)
+	}
+}
@@ -0,0 +1,46 @@
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+var codeToStatus = map[Code]int{
+	ValidationFailed: http.StatusBadRequest,
+	NotFound:         http.StatusNotFound,
+	AlreadyExists:    http.StatusConflict,
+	NoPermission:     http.StatusForbidden,
+	Unauthenticated:  http.StatusUnauthorized,
+	Conflict:         http.StatusConflict,
+	Internal:         http.StatusInternalServerError,
+	DeadlineExceeded: http.StatusGatewayTimeout,
+}
+
+type response struct {
+	Code    Code     `json:"code"`
+	Message string   `json:"message"`
+	Details []Detail `json:"details,omitempty"`
+}
+
+// WriteError maps err to an HTTP status code and writes it as a JSON body.
+// Errors that aren't an *Error are reported as Internal.
+func WriteError(w http.ResponseWriter, err error) {
+	var e *Error
+	if !errors.As(err, &e) {
+		e = Wrap(err, Internal, "internal error")
+	}
+
+	status, ok := codeToStatus[e.Code]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(response{
+		Code:    e.Code,
+		Message: e.Message,
+		Details: e.Details,
+	})
+}
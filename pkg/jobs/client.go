@@ -0,0 +1,40 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+)
+
+// Client enqueues jobs onto the asynq task queue.
+type Client struct {
+	client *asynq.Client
+}
+
+// NewClient creates a Client backed by the given Redis connection options.
+func NewClient(redisOpt asynq.RedisClientOpt) *Client {
+	return &Client{client: asynq.NewClient(redisOpt)}
+}
+
+// Close releases the underlying connection pool.
+func (c *Client) Close() error {
+	return c.client.Close()
+}
+
+// EnqueueUserProcess enqueues a TypeUserProcess task for userID. By default
+// it retries up to defaultMaxRetry times with a defaultTimeout per attempt
+// on the defaultQueue; pass opts to override any of these.
+func (c *Client) EnqueueUserProcess(ctx context.Context, userID int, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+	task, err := NewUserProcessTask(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	allOpts := append([]asynq.Option{
+		asynq.MaxRetry(defaultMaxRetry),
+		asynq.Timeout(defaultTimeout),
+		asynq.Queue(defaultQueue),
+	}, opts...)
+
+	return c.client.EnqueueContext(ctx, task, allOpts...)
+}
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+	"github.com/4xguy/project-index/pkg/services"
+)
+
+// NewUserProcessHandler returns an asynq handler for TypeUserProcess tasks
+// that loads the user via userService and runs post-creation processing.
+// Non-retryable failures (e.g. the user no longer exists) are tagged with
+// asynq.SkipRetry so the task isn't retried.
+func NewUserProcessHandler(userService services.UserService) asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		var payload UserProcessPayload
+		if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+			return fmt.Errorf("%w: failed to unmarshal payload: %v", asynq.SkipRetry, err)
+		}
+
+		user, err := userService.GetUser(payload.UserID)
+		if err != nil {
+			if errs.CodeOf(err) == errs.NotFound {
+				return fmt.Errorf("%w: user %d not found", asynq.SkipRetry, payload.UserID)
+			}
+			return fmt.Errorf("failed to get user %d: %w", payload.UserID, err)
+		}
+
+		if err := processUser(user); err != nil {
+			return fmt.Errorf("failed to process user %d: %w", payload.UserID, err)
+		}
+		return nil
+	}
+}
+
+// processUser runs post-creation processing for a user.
+func processUser(user *models.User) error {
+	// Implementation would process the user
+	return nil
+}
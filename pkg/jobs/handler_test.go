@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+	"github.com/4xguy/project-index/pkg/services"
+)
+
+type fakeUserService struct {
+	services.UserService
+	user *models.User
+	err  error
+}
+
+func (f *fakeUserService) GetUser(id int) (*models.User, error) {
+	return f.user, f.err
+}
+
+func TestUserProcessHandlerSuccess(t *testing.T) {
+	svc := &fakeUserService{user: &models.User{ID: 1, Name: "Ada", Email: "ada@example.com"}}
+	handler := NewUserProcessHandler(svc)
+
+	task, err := NewUserProcessTask(1)
+	if err != nil {
+		t.Fatalf("NewUserProcessTask() error = %v", err)
+	}
+
+	if err := handler(context.Background(), task); err != nil {
+		t.Errorf("handler() error = %v, want nil", err)
+	}
+}
+
+func TestUserProcessHandlerSkipsRetryWhenUserMissing(t *testing.T) {
+	svc := &fakeUserService{err: errs.New(errs.NotFound, "user not found")}
+	handler := NewUserProcessHandler(svc)
+
+	task, err := NewUserProcessTask(1)
+	if err != nil {
+		t.Fatalf("NewUserProcessTask() error = %v", err)
+	}
+
+	err = handler(context.Background(), task)
+	if err == nil || !errors.Is(err, asynq.SkipRetry) {
+		t.Errorf("handler() error = %v, want wrapped asynq.SkipRetry", err)
+	}
+}
@@ -0,0 +1,30 @@
+package jobs
+
+import (
+	"github.com/hibiken/asynq"
+)
+
+// Server processes queued tasks.
+type Server struct {
+	server *asynq.Server
+	mux    *asynq.ServeMux
+}
+
+// NewServer creates a Server backed by the given Redis connection options,
+// processing tasks with the given concurrency.
+func NewServer(redisOpt asynq.RedisClientOpt, concurrency int) *Server {
+	return &Server{
+		server: asynq.NewServer(redisOpt, asynq.Config{Concurrency: concurrency}),
+		mux:    asynq.NewServeMux(),
+	}
+}
+
+// RegisterUserProcessHandler wires up the TypeUserProcess handler.
+func (s *Server) RegisterUserProcessHandler(handler asynq.HandlerFunc) {
+	s.mux.HandleFunc(TypeUserProcess, handler)
+}
+
+// Run starts the server and blocks until it shuts down.
+func (s *Server) Run() error {
+	return s.server.Run(s.mux)
+}
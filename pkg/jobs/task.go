@@ -0,0 +1,34 @@
+// Package jobs defines the asynchronous task types processed by the worker
+// and the client used to enqueue them, built on github.com/hibiken/asynq.
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// TypeUserProcess is the asynq task type for processing a newly created user.
+const TypeUserProcess = "user:process"
+
+const (
+	defaultMaxRetry = 5
+	defaultTimeout  = 30 * time.Second
+	defaultQueue    = "default"
+)
+
+// UserProcessPayload is the JSON payload carried by a TypeUserProcess task.
+type UserProcessPayload struct {
+	UserID int `json:"user_id"`
+}
+
+// NewUserProcessTask builds a TypeUserProcess task for the given user.
+func NewUserProcessTask(userID int) (*asynq.Task, error) {
+	payload, err := json.Marshal(UserProcessPayload{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal user process payload: %w", err)
+	}
+	return asynq.NewTask(TypeUserProcess, payload), nil
+}
@@ -0,0 +1,52 @@
+// Package logging provides structured, request-scoped logging built on
+// go.uber.org/zap, threaded through context so handlers and services can
+// log with consistent request ID, user ID, method and path fields.
+package logging
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+type contextKey string
+
+const loggerContextKey contextKey = "logging.logger"
+
+// cell is a mutable holder for a *zap.Logger. Storing a pointer to it in a
+// context.Context lets code further down the call chain (e.g. a handler
+// resolving the authenticated caller) enrich the logger that the request's
+// outermost middleware will use for its final log line.
+type cell struct {
+	mu     sync.Mutex
+	logger *zap.Logger
+}
+
+func newContext(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey, &cell{logger: logger})
+}
+
+// FromContext returns the request-scoped logger injected by WithLogging,
+// falling back to a no-op logger if it hasn't run.
+func FromContext(ctx context.Context) *zap.Logger {
+	c, ok := ctx.Value(loggerContextKey).(*cell)
+	if !ok {
+		return zap.NewNop()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.logger
+}
+
+// AddFields enriches the request-scoped logger in ctx with additional
+// fields. It is a no-op if WithLogging hasn't run.
+func AddFields(ctx context.Context, fields ...zap.Field) {
+	c, ok := ctx.Value(loggerContextKey).(*cell)
+	if !ok {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logger = c.logger.With(fields...)
+}
@@ -0,0 +1,22 @@
+package metrics
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+)
+
+// WithJobMetrics wraps an asynq handler for the given task type, recording
+// async_jobs_total{type,result} for every task it processes.
+func (m *Metrics) WithJobMetrics(taskType string, next asynq.HandlerFunc) asynq.HandlerFunc {
+	return func(ctx context.Context, t *asynq.Task) error {
+		err := next(ctx, t)
+
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		m.AsyncJobsTotal.WithLabelValues(taskType, result).Inc()
+		return err
+	}
+}
@@ -0,0 +1,42 @@
+// Package metrics exposes the Prometheus collectors and HTTP middleware used
+// to instrument the application.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the application's Prometheus collectors.
+type Metrics struct {
+	HTTPRequestsTotal   *prometheus.CounterVec
+	HTTPRequestDuration *prometheus.HistogramVec
+	AsyncJobsTotal      *prometheus.CounterVec
+}
+
+// New creates and registers the application's collectors under the given
+// namespace/subsystem label prefixes.
+func New(namespace, subsystem string, reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		HTTPRequestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "http_requests_total",
+			Help:      "Total number of HTTP requests processed.",
+		}, []string{"method", "route", "status"}),
+		HTTPRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency in seconds.",
+		}, []string{"method", "route", "status"}),
+		AsyncJobsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "async_jobs_total",
+			Help:      "Total number of asynchronous jobs processed.",
+		}, []string{"type", "result"}),
+	}
+
+	reg.MustRegister(m.HTTPRequestsTotal, m.HTTPRequestDuration, m.AsyncJobsTotal)
+	return m
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// statusRecorder wraps an http.ResponseWriter to capture the status code
+// written by the handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// WithMetrics returns middleware that records http_requests_total and
+// http_request_duration_seconds for every request.
+func (m *Metrics) WithMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		route := routeTemplate(r)
+		status := strconv.Itoa(rec.status)
+		m.HTTPRequestsTotal.WithLabelValues(r.Method, route, status).Inc()
+		m.HTTPRequestDuration.WithLabelValues(r.Method, route, status).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeTemplate returns the matched route's path template (e.g.
+// "/users/{id:[0-9]+}") rather than the literal request path, so distinct IDs
+// don't each create their own time series. Requests that don't match any
+// route (404s, probing) are labelled "unmatched" for the same reason.
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return "unmatched"
+}
@@ -0,0 +1,11 @@
+package models
+
+// Status represents the status of an operation
+type Status int
+
+const (
+	StatusPending Status = iota
+	StatusInProgress
+	StatusCompleted
+	StatusFailed
+)
@@ -0,0 +1,83 @@
+// Package models holds the core domain types shared across the application.
+package models
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/4xguy/project-index/pkg/errs"
+)
+
+// User represents a user in the system
+type User struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+	password  string // private field
+}
+
+// NewUser creates a new user with the given name and email
+func NewUser(name, email string) *User {
+	return &User{
+		Name:  name,
+		Email: email,
+	}
+}
+
+// GetID returns the user's ID
+func (u *User) GetID() int {
+	return u.ID
+}
+
+// SetPassword hashes password with bcrypt and stores it as the user's
+// password. It returns an error if the password can't be hashed, e.g.
+// because it exceeds bcrypt's 72-byte input limit.
+func (u *User) SetPassword(password string) error {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+	u.password = string(hashed)
+	return nil
+}
+
+// CheckPassword reports whether plain matches the user's stored password hash.
+func (u *User) CheckPassword(plain string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.password), []byte(plain)) == nil
+}
+
+// PasswordHash returns the user's bcrypt password hash, for persistence by
+// the storage layer.
+func (u *User) PasswordHash() string {
+	return u.password
+}
+
+// SetPasswordHash sets the user's password hash directly, e.g. when
+// reloading a user from storage. Use SetPassword to hash a new plaintext
+// password instead.
+func (u *User) SetPasswordHash(hash string) {
+	u.password = hash
+}
+
+// Validate validates the user data
+func (u *User) Validate() error {
+	var details []errs.Detail
+	if u.Name == "" {
+		details = append(details, errs.Detail{Field: "name", Message: "name is required"})
+	}
+	if u.Email == "" {
+		details = append(details, errs.Detail{Field: "email", Message: "email is required"})
+	}
+	if len(details) > 0 {
+		return errs.New(errs.ValidationFailed, "validation failed").WithDetails(details...)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (u *User) String() string {
+	return fmt.Sprintf("User{ID: %d, Name: %s, Email: %s}", u.ID, u.Name, u.Email)
+}
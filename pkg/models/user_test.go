@@ -0,0 +1,64 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUserValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    *User
+		wantErr bool
+	}{
+		{"valid", &User{Name: "Ada", Email: "ada@example.com"}, false},
+		{"missing name", &User{Email: "ada@example.com"}, true},
+		{"missing email", &User{Name: "Ada"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetPasswordAndCheckPassword(t *testing.T) {
+	u := NewUser("Ada", "ada@example.com")
+	if err := u.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+
+	if !u.CheckPassword("s3cret") {
+		t.Error("CheckPassword() = false, want true for correct password")
+	}
+	if u.CheckPassword("wrong") {
+		t.Error("CheckPassword() = true, want false for incorrect password")
+	}
+}
+
+func TestSetPasswordTooLong(t *testing.T) {
+	u := NewUser("Ada", "ada@example.com")
+	long := strings.Repeat("a", 73) // exceeds bcrypt's 72-byte input limit
+
+	if err := u.SetPassword(long); err == nil {
+		t.Error("SetPassword() error = nil, want error for over-length password")
+	}
+}
+
+func TestSetPasswordHashRoundTrip(t *testing.T) {
+	u := NewUser("Ada", "ada@example.com")
+	if err := u.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+
+	reloaded := NewUser("Ada", "ada@example.com")
+	reloaded.SetPasswordHash(u.PasswordHash())
+
+	if !reloaded.CheckPassword("s3cret") {
+		t.Error("CheckPassword() = false after SetPasswordHash round-trip, want true")
+	}
+}
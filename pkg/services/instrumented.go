@@ -0,0 +1,79 @@
+package services
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+// instrumentedUserService decorates a UserService, recording
+// user_service_operations_total{op,result} for every call.
+type instrumentedUserService struct {
+	inner UserService
+	ops   *prometheus.CounterVec
+}
+
+// NewInstrumentedUserService wraps inner with Prometheus instrumentation,
+// registering its collector on reg. Wrap reg with
+// prometheus.WrapRegistererWithPrefix to apply a namespace/subsystem label
+// prefix. Metrics are opt-in: callers that don't need them can keep using
+// inner directly.
+func NewInstrumentedUserService(inner UserService, reg prometheus.Registerer) UserService {
+	ops := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "user_service_operations_total",
+		Help: "Total number of UserService operations, by result.",
+	}, []string{"op", "result"})
+	reg.MustRegister(ops)
+
+	return &instrumentedUserService{inner: inner, ops: ops}
+}
+
+func (s *instrumentedUserService) observe(op string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	s.ops.WithLabelValues(op, result).Inc()
+}
+
+func (s *instrumentedUserService) ListUsers() ([]*models.User, error) {
+	users, err := s.inner.ListUsers()
+	s.observe("list_users", err)
+	return users, err
+}
+
+func (s *instrumentedUserService) GetUser(id int) (*models.User, error) {
+	user, err := s.inner.GetUser(id)
+	s.observe("get_user", err)
+	return user, err
+}
+
+func (s *instrumentedUserService) CreateUser(user *models.User) error {
+	err := s.inner.CreateUser(user)
+	s.observe("create_user", err)
+	return err
+}
+
+func (s *instrumentedUserService) UpdateUser(user *models.User) error {
+	err := s.inner.UpdateUser(user)
+	s.observe("update_user", err)
+	return err
+}
+
+func (s *instrumentedUserService) DeleteUser(id int) error {
+	err := s.inner.DeleteUser(id)
+	s.observe("delete_user", err)
+	return err
+}
+
+func (s *instrumentedUserService) Authenticate(email, password string) (*models.User, error) {
+	user, err := s.inner.Authenticate(email, password)
+	s.observe("authenticate", err)
+	return user, err
+}
+
+func (s *instrumentedUserService) SetAvatarURL(id int, avatarURL string) (*models.User, error) {
+	user, err := s.inner.SetAvatarURL(id, avatarURL)
+	s.observe("set_avatar_url", err)
+	return user, err
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+func TestInstrumentedUserServiceRecordsResult(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	svc := NewInstrumentedUserService(NewUserService(newFakeDB()), reg)
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+	if _, err := svc.GetUser(999); err == nil {
+		t.Fatal("GetUser() error = nil, want error for missing user")
+	}
+
+	got := counterValue(t, reg, "create_user", "success")
+	if got != 1 {
+		t.Errorf("user_service_operations_total{op=create_user,result=success} = %v, want 1", got)
+	}
+	got = counterValue(t, reg, "get_user", "error")
+	if got != 1 {
+		t.Errorf("user_service_operations_total{op=get_user,result=error} = %v, want 1", got)
+	}
+}
+
+func counterValue(t *testing.T, reg *prometheus.Registry, op, result string) float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	for _, mf := range families {
+		if mf.GetName() != "user_service_operations_total" {
+			continue
+		}
+		for _, metric := range mf.GetMetric() {
+			if hasLabel(metric, "op", op) && hasLabel(metric, "result", result) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func hasLabel(metric *dto.Metric, name, value string) bool {
+	for _, l := range metric.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue() == value
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+// Package services implements the application's business logic on top of
+// the storage layer.
+package services
+
+import (
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+	"github.com/4xguy/project-index/pkg/storage"
+)
+
+// UserService provides user-related operations
+type UserService interface {
+	ListUsers() ([]*models.User, error)
+	GetUser(id int) (*models.User, error)
+	CreateUser(user *models.User) error
+	UpdateUser(user *models.User) error
+	DeleteUser(id int) error
+	Authenticate(email, password string) (*models.User, error)
+	SetAvatarURL(id int, avatarURL string) (*models.User, error)
+}
+
+// userServiceImpl implements UserService
+type userServiceImpl struct {
+	db storage.Database
+}
+
+// NewUserService creates a new UserService
+func NewUserService(db storage.Database) UserService {
+	return &userServiceImpl{db: db}
+}
+
+// ListUsers retrieves all users
+func (s *userServiceImpl) ListUsers() ([]*models.User, error) {
+	return s.db.ListUsers()
+}
+
+// GetUser retrieves a user by ID
+func (s *userServiceImpl) GetUser(id int) (*models.User, error) {
+	return s.db.FindUser(id)
+}
+
+// CreateUser creates a new user
+func (s *userServiceImpl) CreateUser(user *models.User) error {
+	if err := user.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.SaveUser(user)
+}
+
+// UpdateUser updates an existing user
+func (s *userServiceImpl) UpdateUser(user *models.User) error {
+	if err := user.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.UpdateUser(user)
+}
+
+// DeleteUser deletes a user by ID
+func (s *userServiceImpl) DeleteUser(id int) error {
+	return s.db.DeleteUser(id)
+}
+
+// Authenticate validates the given credentials and returns the matching user.
+func (s *userServiceImpl) Authenticate(email, password string) (*models.User, error) {
+	user, err := s.db.FindUserByEmail(email)
+	if err != nil {
+		if errs.CodeOf(err) == errs.NotFound {
+			return nil, errs.New(errs.Unauthenticated, "invalid credentials")
+		}
+		return nil, err
+	}
+	if !user.CheckPassword(password) {
+		return nil, errs.New(errs.Unauthenticated, "invalid credentials")
+	}
+	return user, nil
+}
+
+// SetAvatarURL updates the user's avatar URL.
+func (s *userServiceImpl) SetAvatarURL(id int, avatarURL string) (*models.User, error) {
+	user, err := s.db.FindUser(id)
+	if err != nil {
+		return nil, err
+	}
+	user.AvatarURL = avatarURL
+	if err := s.db.UpdateUser(user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
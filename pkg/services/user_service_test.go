@@ -0,0 +1,124 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+type fakeDB struct {
+	users map[int]*models.User
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{users: make(map[int]*models.User)}
+}
+
+func (f *fakeDB) ListUsers() ([]*models.User, error) {
+	var users []*models.User
+	for _, u := range f.users {
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+func (f *fakeDB) FindUser(id int) (*models.User, error) {
+	if u, ok := f.users[id]; ok {
+		return u, nil
+	}
+	return nil, errs.New(errs.NotFound, "user not found")
+}
+
+func (f *fakeDB) FindUserByEmail(email string) (*models.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, errs.New(errs.NotFound, "user not found")
+}
+
+func (f *fakeDB) SaveUser(user *models.User) error {
+	user.ID = len(f.users) + 1
+	f.users[user.ID] = user
+	return nil
+}
+
+func (f *fakeDB) UpdateUser(user *models.User) error {
+	if _, ok := f.users[user.ID]; !ok {
+		return errs.New(errs.NotFound, "user not found")
+	}
+	f.users[user.ID] = user
+	return nil
+}
+
+func (f *fakeDB) DeleteUser(id int) error {
+	delete(f.users, id)
+	return nil
+}
+
+func TestCreateAndGetUser(t *testing.T) {
+	svc := NewUserService(newFakeDB())
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	got, err := svc.GetUser(user.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if got.Email != "ada@example.com" {
+		t.Errorf("Email = %q, want ada@example.com", got.Email)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	svc := NewUserService(newFakeDB())
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := user.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if _, err := svc.Authenticate("ada@example.com", "s3cret"); err != nil {
+		t.Errorf("Authenticate() error = %v, want nil", err)
+	}
+	_, err := svc.Authenticate("ada@example.com", "wrong")
+	if err == nil {
+		t.Fatal("Authenticate() error = nil, want error for wrong password")
+	}
+	if code := errs.CodeOf(err); code != errs.Unauthenticated {
+		t.Errorf("CodeOf() = %v, want Unauthenticated", code)
+	}
+}
+
+func TestSetAvatarURL(t *testing.T) {
+	svc := NewUserService(newFakeDB())
+
+	user := &models.User{Name: "Ada", Email: "ada@example.com"}
+	if err := svc.CreateUser(user); err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	updated, err := svc.SetAvatarURL(user.ID, "https://objects.example.com/avatars/abc.png")
+	if err != nil {
+		t.Fatalf("SetAvatarURL() error = %v", err)
+	}
+	if updated.AvatarURL != "https://objects.example.com/avatars/abc.png" {
+		t.Errorf("AvatarURL = %q, want the stored URL", updated.AvatarURL)
+	}
+
+	got, err := svc.GetUser(user.ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if got.AvatarURL != updated.AvatarURL {
+		t.Errorf("GetUser().AvatarURL = %q, want %q", got.AvatarURL, updated.AvatarURL)
+	}
+}
@@ -0,0 +1,81 @@
+// Package objects defines an object-storage abstraction and a MinIO/S3
+// implementation of it, used for storing user-uploaded files.
+package objects
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// ObjectStore is the interface for object storage.
+type ObjectStore interface {
+	PutObject(ctx context.Context, bucket, key string, r io.Reader, size int64, contentType string) (url string, err error)
+	GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+	DeleteObject(ctx context.Context, bucket, key string) error
+	PresignedGetURL(ctx context.Context, bucket, key string, expiry time.Duration) (string, error)
+}
+
+// MinioStore is an ObjectStore backed by MinIO (or any S3-compatible endpoint).
+type MinioStore struct {
+	client *minio.Client
+	useSSL bool
+}
+
+// NewMinioStore creates a MinioStore connected to the given endpoint.
+func NewMinioStore(endpoint, accessKey, secretKey string, useSSL bool) (*MinioStore, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create minio client: %w", err)
+	}
+	return &MinioStore{client: client, useSSL: useSSL}, nil
+}
+
+// PutObject uploads r to bucket/key and returns the object's URL.
+func (m *MinioStore) PutObject(ctx context.Context, bucket, key string, r io.Reader, size int64, contentType string) (string, error) {
+	_, err := m.client.PutObject(ctx, bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to put object: %w", err)
+	}
+
+	scheme := "http"
+	if m.useSSL {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/%s/%s", scheme, m.client.EndpointURL().Host, bucket, key), nil
+}
+
+// GetObject returns a reader for bucket/key. The caller must close it.
+func (m *MinioStore) GetObject(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	obj, err := m.client.GetObject(ctx, bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return obj, nil
+}
+
+// DeleteObject removes bucket/key.
+func (m *MinioStore) DeleteObject(ctx context.Context, bucket, key string) error {
+	if err := m.client.RemoveObject(ctx, bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+// PresignedGetURL returns a time-limited URL for downloading bucket/key.
+func (m *MinioStore) PresignedGetURL(ctx context.Context, bucket, key string, expiry time.Duration) (string, error) {
+	u, err := m.client.PresignedGetObject(ctx, bucket, key, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object url: %w", err)
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,140 @@
+// Package storage defines the data-access abstraction used by the services
+// layer and a PostgreSQL-backed implementation of it.
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/4xguy/project-index/pkg/errs"
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+const pqUniqueViolation = "23505"
+
+// Database is the interface for data access
+type Database interface {
+	ListUsers() ([]*models.User, error)
+	FindUser(id int) (*models.User, error)
+	FindUserByEmail(email string) (*models.User, error)
+	SaveUser(user *models.User) error
+	UpdateUser(user *models.User) error
+	DeleteUser(id int) error
+}
+
+// PostgresDB is a Database backed by PostgreSQL.
+type PostgresDB struct {
+	db *sql.DB
+}
+
+// NewPostgresDB opens a connection pool to the given PostgreSQL DSN.
+func NewPostgresDB(dsn string) (*PostgresDB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return &PostgresDB{db: db}, nil
+}
+
+// ListUsers retrieves all users
+func (p *PostgresDB) ListUsers() ([]*models.User, error) {
+	rows, err := p.db.Query(`SELECT id, name, email, role, avatar_url, password_hash FROM users`)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.Internal, "failed to list users")
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		var passwordHash string
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.Role, &user.AvatarURL, &passwordHash); err != nil {
+			return nil, errs.Wrap(err, errs.Internal, "failed to scan user")
+		}
+		user.SetPasswordHash(passwordHash)
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errs.Wrap(err, errs.Internal, "failed to list users")
+	}
+	return users, nil
+}
+
+// FindUser retrieves a user by ID
+func (p *PostgresDB) FindUser(id int) (*models.User, error) {
+	row := p.db.QueryRow(`SELECT id, name, email, role, avatar_url, password_hash FROM users WHERE id = $1`, id)
+
+	var user models.User
+	var passwordHash string
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Role, &user.AvatarURL, &passwordHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.Wrap(err, errs.NotFound, "user not found")
+		}
+		return nil, errs.Wrap(err, errs.Internal, "failed to find user")
+	}
+	user.SetPasswordHash(passwordHash)
+	return &user, nil
+}
+
+// FindUserByEmail retrieves a user by email
+func (p *PostgresDB) FindUserByEmail(email string) (*models.User, error) {
+	row := p.db.QueryRow(`SELECT id, name, email, role, avatar_url, password_hash FROM users WHERE email = $1`, email)
+
+	var user models.User
+	var passwordHash string
+	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.Role, &user.AvatarURL, &passwordHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.Wrap(err, errs.NotFound, "user not found")
+		}
+		return nil, errs.Wrap(err, errs.Internal, "failed to find user by email")
+	}
+	user.SetPasswordHash(passwordHash)
+	return &user, nil
+}
+
+// SaveUser inserts a new user
+func (p *PostgresDB) SaveUser(user *models.User) error {
+	row := p.db.QueryRow(
+		`INSERT INTO users (name, email, role, avatar_url, password_hash) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		user.Name, user.Email, user.Role, user.AvatarURL, user.PasswordHash(),
+	)
+	if err := row.Scan(&user.ID); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation {
+			return errs.Wrap(err, errs.AlreadyExists, "a user with this email already exists")
+		}
+		return errs.Wrap(err, errs.Internal, "failed to save user")
+	}
+	return nil
+}
+
+// UpdateUser updates an existing user
+func (p *PostgresDB) UpdateUser(user *models.User) error {
+	_, err := p.db.Exec(
+		`UPDATE users SET name = $1, email = $2, role = $3, avatar_url = $4, password_hash = $5 WHERE id = $6`,
+		user.Name, user.Email, user.Role, user.AvatarURL, user.PasswordHash(), user.ID,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == pqUniqueViolation {
+			return errs.Wrap(err, errs.AlreadyExists, "a user with this email already exists")
+		}
+		return errs.Wrap(err, errs.Internal, "failed to update user")
+	}
+	return nil
+}
+
+// DeleteUser deletes a user by ID
+func (p *PostgresDB) DeleteUser(id int) error {
+	_, err := p.db.Exec(`DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return errs.Wrap(err, errs.Internal, "failed to delete user")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/4xguy/project-index/pkg/models"
+)
+
+func newMockDB(t *testing.T) (*PostgresDB, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return &PostgresDB{db: db}, mock
+}
+
+// TestSaveUserPersistsPasswordHash guards against the password hash being
+// silently dropped between models.User.SetPassword and the users table: a
+// hash set via SetPassword must be part of the INSERT. Reading it back is
+// covered by TestFindUserScansPasswordHash.
+func TestSaveUserPersistsPasswordHash(t *testing.T) {
+	p, mock := newMockDB(t)
+
+	user := models.NewUser("Ada", "ada@example.com")
+	if err := user.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+
+	mock.ExpectQuery(`INSERT INTO users \(name, email, role, avatar_url, password_hash\) VALUES \(\$1, \$2, \$3, \$4, \$5\) RETURNING id`).
+		WithArgs(user.Name, user.Email, user.Role, user.AvatarURL, user.PasswordHash()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	if err := p.SaveUser(user); err != nil {
+		t.Fatalf("SaveUser() error = %v", err)
+	}
+	if user.GetID() != 1 {
+		t.Errorf("GetID() = %d, want 1", user.GetID())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestFindUserScansPasswordHash(t *testing.T) {
+	p, mock := newMockDB(t)
+
+	want := models.NewUser("Ada", "ada@example.com")
+	if err := want.SetPassword("s3cret"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+
+	mock.ExpectQuery(`SELECT id, name, email, role, avatar_url, password_hash FROM users WHERE id = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email", "role", "avatar_url", "password_hash"}).
+			AddRow(1, want.Name, want.Email, want.Role, want.AvatarURL, want.PasswordHash()))
+
+	got, err := p.FindUser(1)
+	if err != nil {
+		t.Fatalf("FindUser() error = %v", err)
+	}
+	if !got.CheckPassword("s3cret") {
+		t.Error("CheckPassword() = false, want true for the hash scanned from the row")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestUpdateUserPersistsPasswordHash(t *testing.T) {
+	p, mock := newMockDB(t)
+
+	user := models.NewUser("Ada", "ada@example.com")
+	user.ID = 1
+	if err := user.SetPassword("newpass"); err != nil {
+		t.Fatalf("SetPassword() error = %v", err)
+	}
+
+	mock.ExpectExec(`UPDATE users SET name = \$1, email = \$2, role = \$3, avatar_url = \$4, password_hash = \$5 WHERE id = \$6`).
+		WithArgs(user.Name, user.Email, user.Role, user.AvatarURL, user.PasswordHash(), user.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := p.UpdateUser(user); err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestFindUserNotFound(t *testing.T) {
+	p, mock := newMockDB(t)
+
+	mock.ExpectQuery(`SELECT id, name, email, role, avatar_url, password_hash FROM users WHERE id = \$1`).
+		WithArgs(99).
+		WillReturnError(sql.ErrNoRows)
+
+	if _, err := p.FindUser(99); err == nil {
+		t.Error("FindUser() error = nil, want error for missing row")
+	}
+}
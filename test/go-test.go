@@ -1,244 +0,0 @@
-package main
-
-import (
-	"fmt"
-	"log"
-	"net/http"
-	"context"
-	yaml "gopkg.in/yaml.v2"
-	. "github.com/example/utils"
-)
-
-// User represents a user in the system
-type User struct {
-	ID       int    `json:"id"`
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	password string // private field
-}
-
-// UserService provides user-related operations
-type UserService interface {
-	GetUser(id int) (*User, error)
-	CreateUser(user *User) error
-	UpdateUser(user *User) error
-	DeleteUser(id int) error
-}
-
-// Config holds application configuration
-type Config struct {
-	Port     int    `yaml:"port"`
-	Database string `yaml:"database"`
-}
-
-// Status represents the status of an operation
-type Status int
-
-const (
-	StatusPending Status = iota
-	StatusInProgress
-	StatusCompleted
-	StatusFailed
-)
-
-var (
-	// DefaultConfig is the default configuration
-	DefaultConfig = Config{
-		Port:     8080,
-		Database: "postgres://localhost/app",
-	}
-	
-	// Version of the application
-	Version = "1.0.0"
-)
-
-// NewUser creates a new user with the given name and email
-func NewUser(name, email string) *User {
-	return &User{
-		Name:  name,
-		Email: email,
-	}
-}
-
-// GetID returns the user's ID
-func (u *User) GetID() int {
-	return u.ID
-}
-
-// SetPassword sets the user's password
-func (u *User) SetPassword(password string) {
-	u.password = hashPassword(password)
-}
-
-// Validate validates the user data
-func (u *User) Validate() error {
-	if u.Name == "" {
-		return fmt.Errorf("name is required")
-	}
-	if u.Email == "" {
-		return fmt.Errorf("email is required")
-	}
-	return nil
-}
-
-// String implements the Stringer interface
-func (u *User) String() string {
-	return fmt.Sprintf("User{ID: %d, Name: %s, Email: %s}", u.ID, u.Name, u.Email)
-}
-
-// userServiceImpl implements UserService
-type userServiceImpl struct {
-	db Database
-}
-
-// NewUserService creates a new UserService
-func NewUserService(db Database) UserService {
-	return &userServiceImpl{db: db}
-}
-
-// GetUser retrieves a user by ID
-func (s *userServiceImpl) GetUser(id int) (*User, error) {
-	user, err := s.db.FindUser(id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
-	}
-	return user, nil
-}
-
-// CreateUser creates a new user
-func (s *userServiceImpl) CreateUser(user *User) error {
-	if err := user.Validate(); err != nil {
-		return err
-	}
-	
-	return s.db.SaveUser(user)
-}
-
-// UpdateUser updates an existing user
-func (s *userServiceImpl) UpdateUser(user *User) error {
-	if err := user.Validate(); err != nil {
-		return err
-	}
-	
-	return s.db.UpdateUser(user)
-}
-
-// DeleteUser deletes a user by ID
-func (s *userServiceImpl) DeleteUser(id int) error {
-	return s.db.DeleteUser(id)
-}
-
-// handleUsers handles HTTP requests for users
-func handleUsers(w http.ResponseWriter, r *http.Request) {
-	switch r.Method {
-	case http.MethodGet:
-		getUsers(w, r)
-	case http.MethodPost:
-		createUser(w, r)
-	default:
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-	}
-}
-
-// getUsers handles GET requests for users
-func getUsers(w http.ResponseWriter, r *http.Request) {
-	users, err := getUserList()
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	
-	writeJSON(w, users)
-}
-
-// createUser handles POST requests to create users
-func createUser(w http.ResponseWriter, r *http.Request) {
-	var user User
-	if err := readJSON(r, &user); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-	
-	service := NewUserService(getDatabase())
-	if err := service.CreateUser(&user); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	
-	w.WriteHeader(http.StatusCreated)
-	writeJSON(w, user)
-}
-
-// processUserAsync processes a user asynchronously
-func processUserAsync(ctx context.Context, userID int) {
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				log.Printf("Recovered from panic: %v", r)
-			}
-		}()
-		
-		user, err := getUserByID(userID)
-		if err != nil {
-			log.Printf("Failed to get user %d: %v", userID, err)
-			return
-		}
-		
-		if err := processUser(user); err != nil {
-			log.Printf("Failed to process user %d: %v", userID, err)
-		}
-	}()
-}
-
-// Helper functions (private)
-func hashPassword(password string) string {
-	// Implementation would hash the password
-	return password
-}
-
-func getUserList() ([]*User, error) {
-	// Implementation would return list of users
-	return nil, nil
-}
-
-func getUserByID(id int) (*User, error) {
-	// Implementation would get user by ID
-	return nil, nil
-}
-
-func processUser(user *User) error {
-	// Implementation would process the user
-	return nil
-}
-
-func getDatabase() Database {
-	// Implementation would return database connection
-	return nil
-}
-
-func readJSON(r *http.Request, v interface{}) error {
-	// Implementation would read JSON from request
-	return nil
-}
-
-func writeJSON(w http.ResponseWriter, v interface{}) error {
-	// Implementation would write JSON to response
-	return nil
-}
-
-// Database interface for data access
-type Database interface {
-	FindUser(id int) (*User, error)
-	SaveUser(user *User) error
-	UpdateUser(user *User) error
-	DeleteUser(id int) error
-}
-
-func main() {
-	config := DefaultConfig
-	
-	http.HandleFunc("/users", handleUsers)
-	
-	fmt.Printf("Server starting on port %d\n", config.Port)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), nil))
-}
\ No newline at end of file